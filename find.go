@@ -0,0 +1,59 @@
+package list
+
+// Do calls f for each element of l, from Front to Back, stopping early if
+// f returns false. It is safe for f to remove the element it was called
+// with, since the next element is captured before f runs.
+func (l *List[T]) Do(f func(*Element[T]) bool) {
+	for e := l.Front(); e != nil; {
+		next := e.Next()
+		if !f(e) {
+			return
+		}
+		e = next
+	}
+}
+
+// FindFunc returns the first element for which pred reports true, or nil
+// if no element matches.
+func (l *List[T]) FindFunc(pred func(T) bool) *Element[T] {
+	for e := l.Front(); e != nil; e = e.Next() {
+		if pred(e.Value) {
+			return e
+		}
+	}
+	return nil
+}
+
+// ContainsFunc reports whether any element of l satisfies pred.
+func (l *List[T]) ContainsFunc(pred func(T) bool) bool {
+	return l.FindFunc(pred) != nil
+}
+
+// IndexFunc returns the index of the first element satisfying pred, or -1
+// if no element matches.
+func (l *List[T]) IndexFunc(pred func(T) bool) int {
+	i := 0
+	for e := l.Front(); e != nil; e = e.Next() {
+		if pred(e.Value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// FilterInPlace removes, in a single forward pass, every element whose
+// value does not satisfy keep, and returns the number of elements
+// removed.
+func (l *List[T]) FilterInPlace(keep func(T) bool) int {
+	removed := 0
+	for e := l.Front(); e != nil; {
+		next := e.Next()
+		if !keep(e.Value) {
+			l.Remove(e)
+			removed++
+		}
+		e = next
+	}
+	return removed
+}
@@ -0,0 +1,95 @@
+package list
+
+import "testing"
+
+func TestFindFunc(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	e2 := l.PushBack(2)
+	l.PushBack(3)
+
+	if got := l.FindFunc(func(v int) bool { return v == 2 }); got != e2 {
+		t.Errorf("FindFunc(==2) = %v, want %v", got, e2)
+	}
+	if got := l.FindFunc(func(v int) bool { return v == 4 }); got != nil {
+		t.Errorf("FindFunc(==4) = %v, want nil", got)
+	}
+}
+
+func TestContainsFunc(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+
+	if !l.ContainsFunc(func(v int) bool { return v == 2 }) {
+		t.Errorf("ContainsFunc(==2) = false, want true")
+	}
+	if l.ContainsFunc(func(v int) bool { return v == 3 }) {
+		t.Errorf("ContainsFunc(==3) = true, want false")
+	}
+}
+
+func TestIndexFunc(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+
+	if i := l.IndexFunc(func(v int) bool { return v == 3 }); i != 2 {
+		t.Errorf("IndexFunc(==3) = %d, want 2", i)
+	}
+	if i := l.IndexFunc(func(v int) bool { return v == 4 }); i != -1 {
+		t.Errorf("IndexFunc(==4) = %d, want -1", i)
+	}
+}
+
+func TestFilterInPlace(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+	l.PushBack(4)
+	l.PushBack(5)
+
+	removed := l.FilterInPlace(func(v int) bool { return v%2 == 0 })
+	if removed != 3 {
+		t.Errorf("FilterInPlace removed = %d, want 3", removed)
+	}
+	checkList(t, l, []int{2, 4})
+}
+
+func TestDo(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+
+	var got []int
+	l.Do(func(e *Element[int]) bool {
+		got = append(got, e.Value)
+		return e.Value != 2
+	})
+
+	want := []int{1, 2}
+	if len(got) != len(want) {
+		t.Fatalf("Do visited %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("got[%d] = %d, want %d", i, got[i], v)
+		}
+	}
+}
+
+func TestDoRemoveCurrent(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+
+	l.Do(func(e *Element[int]) bool {
+		l.Remove(e)
+		return true
+	})
+	checkListLen(t, l, 0)
+}
@@ -0,0 +1,102 @@
+package list
+
+import "iter"
+
+// All returns an iterator over index-value pairs of l, traversing it from
+// Front to Back, as in:
+//
+//	for i, v := range l.All() {
+//		// ...
+//	}
+//
+// The index counts the values yielded so far and starts at 0; it is not
+// stored on the element, and removals during iteration do not renumber it.
+// It is safe to remove the current element during iteration.
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for e := l.Front(); e != nil; {
+			next := e.Next()
+			if !yield(i, e.Value) {
+				return
+			}
+			i++
+			e = next
+		}
+	}
+}
+
+// Values returns an iterator over the values of l, traversing it from Front
+// to Back. It is safe to remove the current element during iteration.
+func (l *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; {
+			next := e.Next()
+			if !yield(e.Value) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+// Elements returns an iterator over the elements of l, traversing it from
+// Front to Back. It is safe to remove the current element during iteration.
+func (l *List[T]) Elements() iter.Seq[*Element[T]] {
+	return func(yield func(*Element[T]) bool) {
+		for e := l.Front(); e != nil; {
+			next := e.Next()
+			if !yield(e) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+// Backward returns an iterator over index-value pairs of l, traversing it
+// from Back to Front. The index counts the values yielded so far and starts
+// at 0; removals during iteration do not renumber it.
+func (l *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for e := l.Back(); e != nil; {
+			prev := e.Prev()
+			if !yield(i, e.Value) {
+				return
+			}
+			i++
+			e = prev
+		}
+	}
+}
+
+// BackwardValues returns an iterator over the values of l, traversing it
+// from Back to Front. It is safe to remove the current element during
+// iteration.
+func (l *List[T]) BackwardValues() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Back(); e != nil; {
+			prev := e.Prev()
+			if !yield(e.Value) {
+				return
+			}
+			e = prev
+		}
+	}
+}
+
+// BackwardElements returns an iterator over the elements of l, traversing it
+// from Back to Front. It is safe to remove the current element during
+// iteration.
+func (l *List[T]) BackwardElements() iter.Seq[*Element[T]] {
+	return func(yield func(*Element[T]) bool) {
+		for e := l.Back(); e != nil; {
+			prev := e.Prev()
+			if !yield(e) {
+				return
+			}
+			e = prev
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package list
+
+import "testing"
+
+func TestIterValues(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+
+	var got []int
+	for v := range l.Values() {
+		got = append(got, v)
+	}
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("Values() = %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("got[%d] = %d, want %d", i, got[i], v)
+		}
+	}
+}
+
+func TestIterAll(t *testing.T) {
+	l := New[string]()
+	l.PushBack("a")
+	l.PushBack("b")
+	l.PushBack("c")
+
+	var idx []int
+	var vals []string
+	for i, v := range l.All() {
+		idx = append(idx, i)
+		vals = append(vals, v)
+	}
+	wantIdx := []int{0, 1, 2}
+	wantVals := []string{"a", "b", "c"}
+	for i := range wantIdx {
+		if idx[i] != wantIdx[i] || vals[i] != wantVals[i] {
+			t.Errorf("All()[%d] = (%d, %q), want (%d, %q)", i, idx[i], vals[i], wantIdx[i], wantVals[i])
+		}
+	}
+}
+
+func TestIterBackwardValues(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+
+	var got []int
+	for v := range l.BackwardValues() {
+		got = append(got, v)
+	}
+	want := []int{3, 2, 1}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("got[%d] = %d, want %d", i, got[i], v)
+		}
+	}
+}
+
+func TestIterBreak(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+
+	var got []int
+	for v := range l.Values() {
+		if v == 2 {
+			break
+		}
+		got = append(got, v)
+	}
+	if len(got) != 1 || got[0] != 1 {
+		t.Errorf("got = %v, want [1]", got)
+	}
+}
+
+// TestIterRemoveCurrent verifies that removing the current element during
+// iteration does not break traversal, mirroring the "clear all elements by
+// iterating" pattern in TestList.
+func TestIterRemoveCurrent(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+
+	var got []int
+	for e := range l.Elements() {
+		got = append(got, e.Value)
+		l.Remove(e)
+	}
+	want := []int{1, 2, 3}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("got[%d] = %d, want %d", i, got[i], v)
+		}
+	}
+	checkListLen(t, l, 0)
+}
@@ -0,0 +1,41 @@
+package list
+
+import "sync"
+
+// Pool recycles *Element[T] nodes so that heavy churn through
+// PushFront/PushBack/Remove (caches, schedulers, and similar workloads)
+// does not pay one allocation per insert. The zero value is a valid,
+// empty pool.
+//
+// Attach a Pool to a List with (*List[T]).SetPool; a Pool may be shared
+// across multiple lists. As with List itself, a Pool is not safe for
+// concurrent use without external synchronization: a removed element's
+// fields, including Value, stay readable on the caller's reference only
+// until some goroutine's Get hands the same node out again.
+type Pool[T any] struct {
+	pool sync.Pool
+}
+
+// Get returns a recycled element, or a freshly allocated one if the pool
+// is empty. The returned element is zeroed: Value is the zero value of T,
+// and next, prev, and list are nil.
+func (p *Pool[T]) Get() *Element[T] {
+	if e, ok := p.pool.Get().(*Element[T]); ok {
+		var zero T
+		e.Value = zero
+		return e
+	}
+	return new(Element[T])
+}
+
+// Put returns e to the pool for reuse. e must not be reachable from any
+// list after Put is called. Value is deliberately left untouched here: a
+// caller's existing *Element[T] reference must keep reading the value it
+// held before removal until the node is actually handed out again by
+// Get, which is the point at which it gets zeroed.
+func (p *Pool[T]) Put(e *Element[T]) {
+	e.next = nil
+	e.prev = nil
+	e.list = nil
+	p.pool.Put(e)
+}
@@ -0,0 +1,80 @@
+package list
+
+import "testing"
+
+func TestPoolRecyclesElements(t *testing.T) {
+	p := &Pool[int]{}
+	l := New[int]()
+	l.SetPool(p)
+
+	e := l.PushBack(1)
+	l.Remove(e)
+
+	e2 := l.PushBack(2)
+	if e2 != e {
+		t.Errorf("PushBack after Remove should reuse the recycled element")
+	}
+	if e2.Value != 2 {
+		t.Errorf("e2.Value = %d, want 2", e2.Value)
+	}
+}
+
+func TestPoolRemoveReturnsValue(t *testing.T) {
+	p := &Pool[int]{}
+	l := New[int]()
+	l.SetPool(p)
+
+	e := l.PushBack(1)
+	l.PushBack(2)
+
+	if v := l.Remove(e); v != 1 {
+		t.Errorf("l.Remove(e) = %d, want 1", v)
+	}
+	if e.Value != 1 {
+		t.Errorf("e.Value = %d, want 1 (caller's reference must not see the node zeroed before reuse)", e.Value)
+	}
+	if e.Next() != nil {
+		t.Errorf("e.Next() != nil")
+	}
+	if e.Prev() != nil {
+		t.Errorf("e.Prev() != nil")
+	}
+}
+
+func TestPoolReplaceRecyclesDisplaced(t *testing.T) {
+	p := &Pool[int]{}
+	l := New[int]()
+	l.SetPool(p)
+
+	e1 := l.PushBack(1)
+	newE1 := l.Replace(10, e1)
+
+	e2 := l.PushBack(2)
+	if e2 != e1 {
+		t.Errorf("PushBack after Replace should reuse the displaced element")
+	}
+	if newE1.Value != 10 {
+		t.Errorf("newE1.Value = %d, want 10", newE1.Value)
+	}
+}
+
+func BenchmarkPushBackRemove(b *testing.B) {
+	l := New[int]()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e := l.PushBack(i)
+		l.Remove(e)
+	}
+}
+
+func BenchmarkPushBackRemovePooled(b *testing.B) {
+	l := New[int]()
+	l.SetPool(&Pool[int]{})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e := l.PushBack(i)
+		l.Remove(e)
+	}
+}
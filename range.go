@@ -0,0 +1,127 @@
+package list
+
+// segmentLen reports the number of elements in the inclusive segment
+// [first, last], and whether first and last both belong to l with last
+// reachable from first by following Next pointers. It does not modify l.
+func (l *List[T]) segmentLen(first, last *Element[T]) (n int, ok bool) {
+	if first == nil || last == nil || first.list != l || last.list != l {
+		return 0, false
+	}
+	n = 1
+	for e := first; e != last; n++ {
+		e = e.next
+		if e == &l.root {
+			return 0, false
+		}
+	}
+	return n, true
+}
+
+// segmentContains reports whether e lies within the inclusive segment
+// [first, last].
+func (l *List[T]) segmentContains(first, last, e *Element[T]) bool {
+	for c := first; ; c = c.next {
+		if c == e {
+			return true
+		}
+		if c == last {
+			return false
+		}
+	}
+}
+
+// unlinkSegment removes the already-validated inclusive segment [first,
+// last] (n elements) from l and decrements l.len. The caller must have
+// validated the segment with segmentLen.
+func (l *List[T]) unlinkSegment(first, last *Element[T], n int) {
+	before, after := first.prev, last.next
+	before.next = after
+	after.prev = before
+	l.len -= n
+
+	first.prev = nil
+	last.next = nil
+}
+
+// linkSegment inserts the detached inclusive segment [first, last] (n
+// elements) into l immediately after at, reassigning list on each
+// transferred element.
+func (l *List[T]) linkSegment(first, last *Element[T], n int, at *Element[T]) {
+	first.prev = at
+	last.next = at.next
+	first.prev.next = first
+	last.next.prev = last
+
+	for e := first; ; e = e.next {
+		e.list = l
+		if e == last {
+			break
+		}
+	}
+	l.len += n
+}
+
+// MoveRange moves the inclusive segment [first, last] of l to immediately
+// after mark, possibly transplanting it into mark's list. If first or last
+// are not elements of l, if last is not reachable from first by following
+// Next pointers, if mark is not an element of an initialized list, or if
+// mark lies within [first, last], the lists are not modified.
+func (l *List[T]) MoveRange(first, last, mark *Element[T]) {
+	n, ok := l.segmentLen(first, last)
+	if !ok || mark == nil || mark.list == nil || l.segmentContains(first, last, mark) {
+		return
+	}
+	dst := mark.list
+	l.unlinkSegment(first, last, n)
+	dst.linkSegment(first, last, n, mark)
+}
+
+// SpliceBefore moves the inclusive segment [first, last] of l to
+// immediately before mark, possibly transplanting it into mark's list. The
+// validation and no-op rules are the same as for MoveRange.
+func (l *List[T]) SpliceBefore(mark, first, last *Element[T]) {
+	n, ok := l.segmentLen(first, last)
+	if !ok || mark == nil || mark.list == nil || l.segmentContains(first, last, mark) {
+		return
+	}
+	dst := mark.list
+	l.unlinkSegment(first, last, n)
+	dst.linkSegment(first, last, n, mark.prev)
+}
+
+// CutRange detaches the inclusive segment [first, last] of l and returns it
+// as a new list. If first or last are not elements of l, or if last is not
+// reachable from first by following Next pointers, l is not modified and
+// CutRange returns a new, empty list.
+func (l *List[T]) CutRange(first, last *Element[T]) *List[T] {
+	nl := New[T]()
+	n, ok := l.segmentLen(first, last)
+	if !ok {
+		return nl
+	}
+	l.unlinkSegment(first, last, n)
+	nl.linkSegment(first, last, n, &nl.root)
+	return nl
+}
+
+// RemoveRange removes the inclusive segment [first, last] from l. If first
+// or last are not elements of l, or if last is not reachable from first by
+// following Next pointers, l is not modified.
+func (l *List[T]) RemoveRange(first, last *Element[T]) {
+	n, ok := l.segmentLen(first, last)
+	if !ok {
+		return
+	}
+	l.unlinkSegment(first, last, n)
+	for e := first; e != nil; {
+		next := e.next
+		e.next = nil
+		e.prev = nil
+		e.list = nil
+		if l.pool != nil {
+			// mirrors remove's post-cleanup recycling
+			l.pool.Put(e)
+		}
+		e = next
+	}
+}
@@ -0,0 +1,138 @@
+package list
+
+import "testing"
+
+func TestMoveRangeSameList(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	e4 := l.PushBack(4)
+	e5 := l.PushBack(5)
+
+	l.MoveRange(e2, e3, e5) // move [2,3] to after 5
+	checkListPointers(t, l, []*Element[int]{e1, e4, e5, e2, e3})
+}
+
+func TestMoveRangeAcrossLists(t *testing.T) {
+	l1 := New[int]()
+	e1 := l1.PushBack(1)
+	e2 := l1.PushBack(2)
+	e3 := l1.PushBack(3)
+
+	l2 := New[int]()
+	e4 := l2.PushBack(4)
+	e5 := l2.PushBack(5)
+
+	l1.MoveRange(e2, e3, e4)
+	checkListPointers(t, l1, []*Element[int]{e1})
+	checkListPointers(t, l2, []*Element[int]{e4, e2, e3, e5})
+
+	if e2.list != l2 || e3.list != l2 {
+		t.Errorf("e2.list and e3.list should be l2 after MoveRange")
+	}
+}
+
+func TestMoveRangeUnreachable(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+
+	// last before first: unreachable.
+	l.MoveRange(e3, e1, e2)
+	checkListPointers(t, l, []*Element[int]{e1, e2, e3})
+}
+
+func TestMoveRangeUnknownMark(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+
+	l.MoveRange(e1, e1, new(Element[int]))
+	checkListPointers(t, l, []*Element[int]{e1, e2})
+}
+
+func TestMoveRangeMarkInSegment(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+
+	l.MoveRange(e1, e3, e2) // mark lies within the segment: no-op
+	checkListPointers(t, l, []*Element[int]{e1, e2, e3})
+}
+
+func TestSpliceBefore(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	e4 := l.PushBack(4)
+
+	l.SpliceBefore(e1, e3, e4) // move [3,4] before 1
+	checkListPointers(t, l, []*Element[int]{e3, e4, e1, e2})
+}
+
+func TestCutRange(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	e4 := l.PushBack(4)
+
+	cut := l.CutRange(e2, e3)
+	checkListPointers(t, l, []*Element[int]{e1, e4})
+	checkListPointers(t, cut, []*Element[int]{e2, e3})
+
+	if e2.list != cut || e3.list != cut {
+		t.Errorf("e2.list and e3.list should be cut after CutRange")
+	}
+}
+
+func TestCutRangeInvalid(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+
+	cut := l.CutRange(new(Element[int]), new(Element[int]))
+	checkListLen(t, l, 1)
+	checkListLen(t, cut, 0)
+}
+
+func TestRemoveRange(t *testing.T) {
+	l := New[int]()
+	e1 := l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	e4 := l.PushBack(4)
+
+	l.RemoveRange(e2, e3)
+	checkListPointers(t, l, []*Element[int]{e1, e4})
+
+	if e2.list != nil || e3.list != nil {
+		t.Errorf("e2.list and e3.list should be nil after RemoveRange")
+	}
+	if e2.next != nil || e3.prev != nil {
+		t.Errorf("e2.next and e3.prev should be nil after RemoveRange")
+	}
+}
+
+func TestRemoveRangePooled(t *testing.T) {
+	p := &Pool[int]{}
+	l := New[int]()
+	l.SetPool(p)
+
+	l.PushBack(1)
+	e2 := l.PushBack(2)
+	e3 := l.PushBack(3)
+	l.PushBack(4)
+
+	l.RemoveRange(e2, e3)
+
+	recycled := map[*Element[int]]bool{e2: true, e3: true}
+	e5 := l.PushBack(5)
+	e6 := l.PushBack(6)
+	if !recycled[e5] || !recycled[e6] {
+		t.Errorf("PushBack after RemoveRange should reuse nodes recycled to the pool")
+	}
+}
@@ -0,0 +1,111 @@
+package list
+
+import "cmp"
+
+// SortFunc sorts l in place in increasing order as defined by less. Unlike
+// sorting a slice, SortFunc preserves every *Element[T] pointer already
+// held by callers: the same elements remain members of l and keep
+// pointing at the same values, only their relative order (and thus
+// Prev/Next) changes. The sort is stable and runs in O(n log n) time using
+// a bottom-up merge sort that rewires next/prev pointers instead of
+// allocating new elements.
+func (l *List[T]) SortFunc(less func(a, b T) bool) {
+	if l.len < 2 {
+		return
+	}
+
+	// Break the ring into a plain next-linked chain so runs can be
+	// detached and merged without the sentinel getting in the way.
+	head := l.root.next
+	tail := l.root.prev
+	head.prev = nil
+	tail.next = nil
+
+	for k := 1; ; k *= 2 {
+		var resultHead, resultTail *Element[T]
+		runs := 0
+		for p := head; p != nil; runs++ {
+			left := p
+			right := detachRun(left, k)
+			p = nil
+			if right != nil {
+				p = detachRun(right, k)
+			}
+
+			mHead, mTail := mergeRuns(left, right, less)
+			if resultHead == nil {
+				resultHead = mHead
+			} else {
+				resultTail.next = mHead
+				mHead.prev = resultTail
+			}
+			resultTail = mTail
+		}
+		head = resultHead
+		if runs <= 1 {
+			break
+		}
+	}
+
+	// Re-attach the sorted chain to the sentinel.
+	l.root.next = head
+	head.prev = &l.root
+	e := head
+	for e.next != nil {
+		e = e.next
+	}
+	e.next = &l.root
+	l.root.prev = e
+}
+
+// SortOrdered sorts l in place in increasing order using the < operator,
+// as a convenience for element types satisfying cmp.Ordered. See
+// (*List[T]).SortFunc for the properties preserved by the sort.
+func SortOrdered[T cmp.Ordered](l *List[T]) {
+	l.SortFunc(func(a, b T) bool { return a < b })
+}
+
+// detachRun walks up to k elements forward from start along next, severs
+// the chain after the run, and returns the first element after the run
+// (or nil if the chain ended within the run).
+func detachRun[T any](start *Element[T], k int) *Element[T] {
+	e := start
+	for i := 1; i < k && e.next != nil; i++ {
+		e = e.next
+	}
+	next := e.next
+	e.next = nil
+	if next != nil {
+		next.prev = nil
+	}
+	return next
+}
+
+// mergeRuns merges the two next-linked runs a and b, which must not both
+// be nil, rewiring next and prev pointers in place, and returns the head
+// and tail of the merged run. Elements of a are preferred over equal
+// elements of b, so the merge is stable.
+func mergeRuns[T any](a, b *Element[T], less func(a, b T) bool) (head, tail *Element[T]) {
+	var dummy Element[T]
+	tail = &dummy
+	for a != nil && b != nil {
+		if less(b.Value, a.Value) {
+			tail.next, b.prev = b, tail
+			b, tail = b.next, b
+		} else {
+			tail.next, a.prev = a, tail
+			a, tail = a.next, a
+		}
+	}
+	rest := a
+	if rest == nil {
+		rest = b
+	}
+	for rest != nil {
+		tail.next, rest.prev = rest, tail
+		rest, tail = rest.next, rest
+	}
+	head = dummy.next
+	head.prev = nil
+	return head, tail
+}
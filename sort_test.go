@@ -0,0 +1,69 @@
+package list
+
+import "testing"
+
+func TestSortFuncPreservesElements(t *testing.T) {
+	l := New[int]()
+	e5 := l.PushBack(5)
+	e3 := l.PushBack(3)
+	e1 := l.PushBack(1)
+	e4 := l.PushBack(4)
+	e2 := l.PushBack(2)
+
+	l.SortFunc(func(a, b int) bool { return a < b })
+
+	checkListPointers(t, l, []*Element[int]{e1, e2, e3, e4, e5})
+	checkList(t, l, []int{1, 2, 3, 4, 5})
+
+	if e1.Value != 1 || e2.Value != 2 || e3.Value != 3 || e4.Value != 4 || e5.Value != 5 {
+		t.Errorf("element values changed during sort")
+	}
+}
+
+func TestSortFuncEmptyAndSingle(t *testing.T) {
+	l := New[int]()
+	l.SortFunc(func(a, b int) bool { return a < b })
+	checkListLen(t, l, 0)
+
+	e := l.PushBack(1)
+	l.SortFunc(func(a, b int) bool { return a < b })
+	checkListPointers(t, l, []*Element[int]{e})
+}
+
+func TestSortFuncDescending(t *testing.T) {
+	l := New[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+
+	l.SortFunc(func(a, b int) bool { return a > b })
+	checkList(t, l, []int{3, 2, 1})
+}
+
+func TestSortOrdered(t *testing.T) {
+	l := New[string]()
+	l.PushBack("banana")
+	l.PushBack("apple")
+	l.PushBack("cherry")
+
+	SortOrdered(l)
+
+	want := []string{"apple", "banana", "cherry"}
+	i := 0
+	for e := l.Front(); e != nil; e = e.Next() {
+		if e.Value != want[i] {
+			t.Errorf("elt[%d].Value = %v, want %v", i, e.Value, want[i])
+		}
+		i++
+	}
+}
+
+func TestSortFuncOddLength(t *testing.T) {
+	l := New[int]()
+	for _, v := range []int{9, 1, 8, 2, 7, 3, 6} {
+		l.PushBack(v)
+	}
+
+	l.SortFunc(func(a, b int) bool { return a < b })
+	checkList(t, l, []int{1, 2, 3, 6, 7, 8, 9})
+}